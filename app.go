@@ -0,0 +1,201 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Olisnot/Connect4-TUI/internal/theme"
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+// Tab indices, matching the order App.tabs is built in.
+const (
+	tabMainMenu = iota
+	tabGame
+	tabSettings
+	tabHelp
+)
+
+const tabBarHeight = 1
+
+// App is the top-level bubbletea model: a tab bar plus whichever Tab is
+// active. Game logic and rendering live in the Tabs; App only routes
+// input and frames to the active one.
+type App struct {
+	buf ui.CellBuffer
+
+	tabs     []ui.Tab
+	active   int
+	settings *Settings
+}
+
+func newApp(mode GameMode, themeName string, th theme.Theme) App {
+	settings := newSettings(themeName, th)
+	return App{
+		tabs: []ui.Tab{
+			tabMainMenu: newMainMenuTab(settings),
+			tabGame:     newGameTab(mode, settings),
+			tabSettings: newSettingsTab(settings),
+			tabHelp:     newHelpTab(),
+		},
+		settings: settings,
+	}
+}
+
+func (a App) Init() tea.Cmd { return tick() }
+
+func (a App) View() string {
+	if !a.buf.Ready() {
+		return ""
+	}
+	return a.buf.String()
+}
+
+// switchTabMsg asks App to make a different tab active; Tabs send this as
+// a tea.Cmd rather than mutating App directly, since a Tab never holds a
+// reference to it.
+type switchTabMsg struct{ index int }
+
+func switchTabCmd(index int) tea.Cmd {
+	return func() tea.Msg { return switchTabMsg{index: index} }
+}
+
+// newGameMsg asks App to reset the Game tab's board and switch to it,
+// same as newGameCmd below.
+type newGameMsg struct{}
+
+func newGameCmd() tea.Cmd {
+	return func() tea.Msg { return newGameMsg{} }
+}
+
+// loadGameMsg asks App to replay a loaded move list into the Game tab's
+// board and switch to it, same as newGameMsg but starting from a save
+// instead of an empty board.
+type loadGameMsg struct{ moves []Move }
+
+func loadGameCmd(moves []Move) tea.Cmd {
+	return func() tea.Msg { return loadGameMsg{moves: moves} }
+}
+
+// netMoveMsg is sent by a networked game's peer-reading goroutine when the
+// other side drops a token.
+type netMoveMsg struct{ col int }
+
+// netErrorMsg is sent by the same goroutine when the connection ends,
+// whether from an error, a resignation, or a clean disconnect. message is
+// already worded for the reason (e.g. "the other player resigned" versus
+// "disconnected: <err>") so the MainMenu banner doesn't blur a win by
+// resignation into a generic disconnect.
+type netErrorMsg struct{ message string }
+
+func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		a.buf.Init(msg.Width, msg.Height)
+		return a, nil
+
+	case switchTabMsg:
+		if msg.index >= 0 && msg.index < len(a.tabs) {
+			a.leaveTab(a.active)
+			a.active = msg.index
+		}
+		return a, nil
+
+	case newGameMsg:
+		a.tabs[tabGame].(*gameTab).reset()
+		a.active = tabGame
+		return a, nil
+
+	case loadGameMsg:
+		gt := a.tabs[tabGame].(*gameTab)
+		gt.reset()
+		gt.replayMoves(msg.moves)
+		a.active = tabGame
+		return a, nil
+
+	case netMoveMsg:
+		a.tabs[tabGame].(*gameTab).applyDrop(msg.col)
+		return a, nil
+
+	case netErrorMsg:
+		a.tabs[tabGame].(*gameTab).netPeer = nil
+		mm := a.tabs[tabMainMenu].(*mainMenuTab)
+		mm.message = msg.message
+		a.active = tabMainMenu
+		return a, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			a.leaveTab(a.active)
+			return a, tea.Quit
+		// Terminals generally don't forward ctrl+tab to applications (window
+		// managers and terminal emulators intercept it for their own tab
+		// switching), so plain tab/shift+tab is the binding that actually works.
+		case "tab":
+			a.leaveTab(a.active)
+			a.active = (a.active + 1) % len(a.tabs)
+			return a, nil
+		case "shift+tab":
+			a.leaveTab(a.active)
+			a.active = (a.active - 1 + len(a.tabs)) % len(a.tabs)
+			return a, nil
+		case "1", "2", "3", "4":
+			if n := int(msg.String()[0] - '1'); n < len(a.tabs) {
+				a.leaveTab(a.active)
+				a.active = n
+			}
+			return a, nil
+		}
+		return a, a.tabs[a.active].HandleKey(msg)
+
+	case tea.MouseMsg:
+		if gt, ok := a.tabs[a.active].(*gameTab); ok {
+			local := msg
+			local.Y -= tabBarHeight
+			gt.handleMouse(local, a.buf.Width(), a.buf.Height()-tabBarHeight)
+		}
+		return a, nil
+
+	case frameMsg:
+		a.tabs[tabGame].(*gameTab).advanceReplay()
+		if !a.buf.Ready() {
+			return a, tick()
+		}
+		a.buf.Wipe()
+		a.draw()
+		return a, tick()
+	}
+	return a, nil
+}
+
+// leaveTab closes any in-progress networked game on the tab at index,
+// should it be the Game tab, so quitting or switching away from a
+// networked match sends BYE instead of just letting the OS tear down the
+// socket.
+func (a App) leaveTab(index int) {
+	if gt, ok := a.tabs[index].(*gameTab); ok {
+		gt.closeNetPeer()
+	}
+}
+
+func (a App) draw() {
+	root := ui.NewGrid(
+		[]ui.GridSpec{ui.FixedSpec(tabBarHeight), ui.WeightedSpec(1)},
+		[]ui.GridSpec{ui.WeightedSpec(1)},
+	)
+	names := make([]string, len(a.tabs))
+	for i, t := range a.tabs {
+		names[i] = t.Name()
+	}
+	bar := &ui.TabBar{
+		Names:         names,
+		Active:        a.active,
+		ActiveStyle:   a.settings.Theme.Highlight,
+		InactiveStyle: a.settings.Theme.StatusBar,
+	}
+	root.AddChild(bar, 0, 0)
+	root.AddChild(a.tabs[a.active].Content(), 1, 0)
+
+	ctx := ui.NewContext(&a.buf, 0, 0, a.buf.Width(), a.buf.Height())
+	root.Draw(ctx)
+}
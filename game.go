@@ -0,0 +1,269 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	cols = 7
+	rows = 6
+
+	defaultAIDepth = 6
+	minAIDepth     = 2
+	maxAIDepth     = 8
+)
+
+// Cell is the contents of a single board slot.
+type Cell int
+
+const (
+	Empty Cell = iota
+	Red
+	Yellow
+)
+
+func (c Cell) String() string {
+	switch c {
+	case Red:
+		return "Red"
+	case Yellow:
+		return "Yellow"
+	default:
+		return ""
+	}
+}
+
+func (c Cell) opponent() Cell {
+	if c == Red {
+		return Yellow
+	}
+	return Red
+}
+
+// Board is the 6x7 grid of dropped tokens, row 0 at the top.
+type Board [rows][cols]Cell
+
+// drop places player's token in the lowest empty row of col, returning the
+// landed row. ok is false if the column is full or out of range.
+func (b *Board) drop(col int, player Cell) (row int, ok bool) {
+	if col < 0 || col >= cols {
+		return 0, false
+	}
+	for r := rows - 1; r >= 0; r-- {
+		if b[r][col] == Empty {
+			b[r][col] = player
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+func (b *Board) full() bool {
+	for c := 0; c < cols; c++ {
+		if b[0][c] == Empty {
+			return false
+		}
+	}
+	return true
+}
+
+var winDirs = [4][2]int{{1, 0}, {0, 1}, {1, 1}, {1, -1}}
+
+// winner reports whether the token dropped at (row, col) completes 4-in-a-row,
+// checking the four axes through that cell.
+func (b *Board) winner(row, col int) bool {
+	player := b[row][col]
+	if player == Empty {
+		return false
+	}
+	for _, d := range winDirs {
+		count := 1
+		count += b.run(row, col, d[0], d[1], player)
+		count += b.run(row, col, -d[0], -d[1], player)
+		if count >= 4 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Board) run(row, col, dr, dc int, player Cell) int {
+	n := 0
+	r, c := row+dr, col+dc
+	for r >= 0 && r < rows && c >= 0 && c < cols && b[r][c] == player {
+		n++
+		r += dr
+		c += dc
+	}
+	return n
+}
+
+type frameMsg struct{}
+
+func tick() tea.Cmd {
+	return tea.Tick(time.Second/fps, func(time.Time) tea.Msg { return frameMsg{} })
+}
+
+// GameMode selects whether Yellow is a human or the AI.
+type GameMode int
+
+const (
+	ModeTwoPlayer GameMode = iota
+	ModeSinglePlayer
+)
+
+// --- AI ---
+//
+// bestMove runs a fixed-depth minimax search with alpha-beta pruning,
+// scoring leaves with scoreBoard from ai's perspective, and returns the
+// column it picks along with that column's score.
+
+func bestMove(b *Board, ai Cell, depth int) (col int, score int) {
+	best := math.MinInt32
+	bestCol := cols / 2
+	for _, c := range columnOrder() {
+		nb := *b
+		row, ok := nb.drop(c, ai)
+		if !ok {
+			continue
+		}
+		s := 1_000_000
+		if !nb.winner(row, c) {
+			s = -negamax(&nb, ai.opponent(), ai, depth-1, math.MinInt32+1, math.MaxInt32-1)
+		}
+		if s > best {
+			best = s
+			bestCol = c
+		}
+	}
+	return bestCol, best
+}
+
+// negamax scores position b, toMove to play next, from ai's perspective,
+// negated at each ply so every level can simply maximize its own score.
+func negamax(b *Board, toMove, ai Cell, depth int, alpha, beta int) int {
+	if depth == 0 || b.full() {
+		return relativeScore(b, toMove, ai)
+	}
+
+	best := math.MinInt32 + 1
+	moved := false
+	for _, c := range columnOrder() {
+		nb := *b
+		row, ok := nb.drop(c, toMove)
+		if !ok {
+			continue
+		}
+		moved = true
+
+		s := 1_000_000
+		if !nb.winner(row, c) {
+			s = -negamax(&nb, toMove.opponent(), ai, depth-1, -beta, -alpha)
+		}
+		if s > best {
+			best = s
+		}
+		if best > alpha {
+			alpha = best
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	if !moved {
+		return relativeScore(b, toMove, ai)
+	}
+	return best
+}
+
+// relativeScore converts scoreBoard's ai-centric value into mover's own
+// perspective, as negamax requires at every leaf.
+func relativeScore(b *Board, mover, ai Cell) int {
+	if mover == ai {
+		return scoreBoard(b, ai)
+	}
+	return -scoreBoard(b, ai)
+}
+
+// columnOrder searches the center columns first, which both finds strong
+// moves sooner (better pruning) and produces natural-looking center bias.
+func columnOrder() []int {
+	order := make([]int, 0, cols)
+	center := cols / 2
+	order = append(order, center)
+	for d := 1; d <= center; d++ {
+		if center-d >= 0 {
+			order = append(order, center-d)
+		}
+		if center+d < cols {
+			order = append(order, center+d)
+		}
+	}
+	return order
+}
+
+// scoreBoard heuristically scores every 4-cell window on the board from
+// ai's perspective: +100/+10/+1 for 4/3/2 of ai's own tokens in an
+// otherwise-empty window, and the symmetric negative for the opponent.
+func scoreBoard(b *Board, ai Cell) int {
+	opp := ai.opponent()
+	score := 0
+
+	// center column bias: tokens near the middle open more lines.
+	center := cols / 2
+	for r := 0; r < rows; r++ {
+		if b[r][center] == ai {
+			score += 3
+		} else if b[r][center] == opp {
+			score -= 3
+		}
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			for _, d := range winDirs {
+				er, ec := r+3*d[0], c+3*d[1]
+				if er < 0 || er >= rows || ec < 0 || ec >= cols {
+					continue
+				}
+				window := [4]Cell{b[r][c], b[r+d[0]][c+d[1]], b[r+2*d[0]][c+2*d[1]], b[er][ec]}
+				score += scoreWindow(window, ai, opp)
+			}
+		}
+	}
+	return score
+}
+
+func scoreWindow(w [4]Cell, ai, opp Cell) int {
+	aiCount, oppCount := 0, 0
+	for _, cell := range w {
+		switch cell {
+		case ai:
+			aiCount++
+		case opp:
+			oppCount++
+		}
+	}
+	if aiCount > 0 && oppCount > 0 {
+		return 0 // contested window, no immediate threat either way
+	}
+	switch {
+	case aiCount == 4:
+		return 100
+	case aiCount == 3:
+		return 10
+	case aiCount == 2:
+		return 1
+	case oppCount == 4:
+		return -100
+	case oppCount == 3:
+		return -10
+	case oppCount == 2:
+		return -1
+	default:
+		return 0
+	}
+}
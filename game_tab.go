@@ -0,0 +1,271 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Olisnot/Connect4-TUI/internal/netplay"
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+// gameTab is the Game screen: the board, whose turn it is, and the
+// win/draw state. It reads its theme and AI depth from a shared
+// *Settings so the Settings tab can change them mid-game.
+type gameTab struct {
+	board  Board
+	col    int
+	turn   Cell
+	winner Cell
+	draw   bool
+	moves  []Move
+
+	mode     GameMode
+	ai       Cell // which color the AI plays, when mode == ModeSinglePlayer
+	settings *Settings
+
+	message string // transient status line, e.g. a save result; cleared on the next move
+
+	// suppressHistory is set while moves are being fed in from a save
+	// (Load from the MainMenu, or -replay) instead of played live, so
+	// replaying an already-finished game doesn't append a second
+	// history.jsonl entry for it. reset clears it for every fresh game.
+	suppressHistory bool
+
+	// replayQueue, when non-empty, feeds moves from a loaded game one at a
+	// time instead of taking input. replayFrames is how many frameMsgs
+	// pass between moves; replayCounter counts down to the next one.
+	replayQueue   []Move
+	replayFrames  int
+	replayCounter int
+
+	// netPeer, when set, means this is a networked game: the local side
+	// only controls localColor, and its drops are sent to the peer
+	// instead of (or alongside) being applied to the AI's side.
+	netPeer    *netplay.Peer
+	localColor Cell
+}
+
+func newGameTab(mode GameMode, settings *Settings) *gameTab {
+	return &gameTab{
+		col:      cols / 2,
+		turn:     Red,
+		mode:     mode,
+		ai:       Yellow,
+		settings: settings,
+	}
+}
+
+func (gt *gameTab) Name() string         { return "Game" }
+func (gt *gameTab) Content() ui.Drawable { return buildGameLayout(gt) }
+
+func (gt *gameTab) gameOver() bool {
+	return gt.winner != Empty || gt.draw
+}
+
+// reset restores a fresh board, keeping the configured mode.
+func (gt *gameTab) reset() {
+	gt.board = Board{}
+	gt.col = cols / 2
+	gt.turn = Red
+	gt.winner = Empty
+	gt.draw = false
+	gt.moves = nil
+	gt.message = ""
+	gt.suppressHistory = false
+}
+
+// applyDrop drops turn's token in col, updates winner/draw state, and
+// advances the turn. It reports whether the drop was legal.
+func (gt *gameTab) applyDrop(col int) bool {
+	if gt.gameOver() {
+		return false
+	}
+	row, ok := gt.board.drop(col, gt.turn)
+	if !ok {
+		return false
+	}
+	gt.message = ""
+	gt.moves = append(gt.moves, Move{Player: gt.turn, Col: col})
+
+	if gt.board.winner(row, col) {
+		gt.winner = gt.turn
+	} else if gt.board.full() {
+		gt.draw = true
+	} else {
+		gt.turn = gt.turn.opponent()
+	}
+
+	if gt.gameOver() && !gt.suppressHistory {
+		if err := recordHistory(gt); err != nil {
+			gt.message = fmt.Sprintf("history: %v", err)
+		}
+	}
+	return true
+}
+
+// replayMoves feeds moves into the board via applyDrop without recording a
+// duplicate history.jsonl entry for a game that already happened; used to
+// reconstruct a loaded save instead of taking live input.
+func (gt *gameTab) replayMoves(moves []Move) {
+	gt.suppressHistory = true
+	for _, m := range moves {
+		gt.applyDrop(m.Col)
+	}
+	gt.suppressHistory = false
+}
+
+// save writes the game so far to a fresh timestamped file under
+// $XDG_DATA_HOME/connect4-tui/saves, reporting the outcome in gt.message.
+func (gt *gameTab) save() {
+	path, err := newSavePath()
+	if err == nil {
+		err = SaveGame(path, gt.moves)
+	}
+	if err != nil {
+		gt.message = fmt.Sprintf("save failed: %v", err)
+		return
+	}
+	gt.message = "saved to " + path
+}
+
+// startNetwork resets the board and puts gt into networked mode: only
+// localColor's drops are accepted from local input, and each one is sent
+// to peer; moves arriving from peer are applied via the App's netMoveMsg
+// handler.
+func (gt *gameTab) startNetwork(peer *netplay.Peer, localColor Cell) {
+	gt.reset()
+	gt.netPeer = peer
+	gt.localColor = localColor
+}
+
+// drop is the entry point for a locally-initiated move, from either the
+// keyboard or the mouse. In a networked game it refuses to move out of
+// turn and forwards successful drops to the peer.
+func (gt *gameTab) drop(col int) {
+	if gt.netPeer != nil && gt.turn != gt.localColor {
+		return
+	}
+	if !gt.applyDrop(col) {
+		return
+	}
+	if gt.netPeer != nil {
+		if err := gt.netPeer.SendMove(col); err != nil {
+			gt.message = fmt.Sprintf("connection lost: %v", err)
+		}
+	}
+	gt.maybeAIMove()
+}
+
+// startReplay resets the board and arranges for moves to be fed in one at a
+// time, every interval, as frameMsgs arrive. HandleKey ignores input while
+// a replay is in progress.
+func (gt *gameTab) startReplay(moves []Move, interval time.Duration) {
+	gt.reset()
+	gt.suppressHistory = true
+	gt.replayQueue = moves
+	gt.replayFrames = int(interval / (time.Second / fps))
+	if gt.replayFrames < 1 {
+		gt.replayFrames = 1
+	}
+	gt.replayCounter = gt.replayFrames
+}
+
+// advanceReplay is called once per frameMsg; it is a no-op unless a replay
+// is in progress.
+func (gt *gameTab) advanceReplay() {
+	if len(gt.replayQueue) == 0 {
+		return
+	}
+	gt.replayCounter--
+	if gt.replayCounter > 0 {
+		return
+	}
+	next := gt.replayQueue[0]
+	gt.replayQueue = gt.replayQueue[1:]
+	gt.applyDrop(next.Col)
+	gt.replayCounter = gt.replayFrames
+	if len(gt.replayQueue) == 0 {
+		// The archived match is done; any further drops are live play
+		// the player chooses to continue with, so let them count again.
+		gt.suppressHistory = false
+	}
+}
+
+// maybeAIMove lets the AI reply immediately after a human move, so a
+// single player mode never waits on the AI's own turn indicator.
+func (gt *gameTab) maybeAIMove() {
+	if gt.mode != ModeSinglePlayer || gt.gameOver() || gt.turn != gt.ai {
+		return
+	}
+	col, _ := bestMove(&gt.board, gt.ai, gt.settings.AIDepth)
+	gt.applyDrop(col)
+}
+
+// handleMouse tracks the column under the pointer as the selected
+// column, same as the arrow keys, and drops on it when the left button
+// is pressed. msg.X/Y must already be local to the space Content() was
+// drawn into. Events outside the board's frame are ignored.
+func (gt *gameTab) handleMouse(msg tea.MouseMsg, areaW, areaH int) {
+	if len(gt.replayQueue) > 0 {
+		return
+	}
+	boardX, boardY, ok := boardOrigin(areaW, areaH)
+	if !ok {
+		return
+	}
+	localX, localY := msg.X-boardX, msg.Y-boardY
+	if localX < 0 || localX >= tableW || localY < 0 || localY >= tableH+2 {
+		return
+	}
+
+	gt.col = clamp(localX/cellW, 0, cols-1)
+	if msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft {
+		gt.drop(gt.col)
+	}
+}
+
+func (gt *gameTab) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if len(gt.replayQueue) > 0 {
+		return nil
+	}
+	switch msg.String() {
+	case "r":
+		if gt.netPeer != nil {
+			return gt.resignCmd()
+		}
+		gt.reset()
+	case "left", "a":
+		gt.col = clamp(gt.col-1, 0, cols-1)
+	case "right", "d":
+		gt.col = clamp(gt.col+1, 0, cols-1)
+	case " ", "enter":
+		gt.drop(gt.col)
+	case "ctrl+s":
+		gt.save()
+	case "q":
+		gt.closeNetPeer()
+		return tea.Quit
+	}
+	return nil
+}
+
+// closeNetPeer says goodbye to a connected peer, if any, so quitting or
+// navigating away from a networked game sends BYE instead of just letting
+// the OS tear down the socket.
+func (gt *gameTab) closeNetPeer() {
+	if gt.netPeer != nil {
+		gt.netPeer.Close()
+		gt.netPeer = nil
+	}
+}
+
+// resignCmd tells the peer the local side is giving up, closes the
+// connection, and returns App to the MainMenu the same way a disconnect
+// would.
+func (gt *gameTab) resignCmd() tea.Cmd {
+	gt.netPeer.SendResign()
+	gt.closeNetPeer()
+	return func() tea.Msg { return netErrorMsg{message: "you resigned"} }
+}
@@ -0,0 +1,162 @@
+package main
+
+import "testing"
+
+// dropAll drops player's token in each of cols in order, failing the test
+// if any drop is rejected.
+func dropAll(t *testing.T, b *Board, player Cell, cols ...int) (row, col int) {
+	t.Helper()
+	for _, c := range cols {
+		r, ok := b.drop(c, player)
+		if !ok {
+			t.Fatalf("drop(%d, %v) rejected on board %v", c, player, b)
+		}
+		row, col = r, c
+	}
+	return row, col
+}
+
+func TestWinnerHorizontal(t *testing.T) {
+	var b Board
+	row, col := dropAll(t, &b, Red, 0, 1, 2, 3)
+	if !b.winner(row, col) {
+		t.Fatalf("expected horizontal win at (%d,%d)", row, col)
+	}
+}
+
+func TestWinnerVertical(t *testing.T) {
+	var b Board
+	var row, col int
+	for i := 0; i < 4; i++ {
+		row, col = dropAll(t, &b, Red, 0)
+	}
+	if !b.winner(row, col) {
+		t.Fatalf("expected vertical win at (%d,%d)", row, col)
+	}
+}
+
+func TestWinnerAntiDiagonal(t *testing.T) {
+	// Build a "/" diagonal (bottom-left to top-right) with Yellow filler
+	// under the higher columns so Red's tokens land on the same diagonal.
+	var b Board
+	dropAll(t, &b, Red, 0)
+	dropAll(t, &b, Yellow, 1)
+	dropAll(t, &b, Red, 1)
+	dropAll(t, &b, Yellow, 2)
+	dropAll(t, &b, Yellow, 2)
+	dropAll(t, &b, Red, 2)
+	dropAll(t, &b, Yellow, 3)
+	dropAll(t, &b, Yellow, 3)
+	dropAll(t, &b, Yellow, 3)
+	row, col := dropAll(t, &b, Red, 3)
+	if !b.winner(row, col) {
+		t.Fatalf("expected diagonal win at (%d,%d), board:\n%v", row, col, b)
+	}
+}
+
+func TestWinnerMainDiagonal(t *testing.T) {
+	// Build a "\" diagonal (top-left to bottom-right), the mirror image of
+	// TestWinnerAntiDiagonal.
+	var b Board
+	dropAll(t, &b, Yellow, 0)
+	dropAll(t, &b, Yellow, 0)
+	dropAll(t, &b, Yellow, 0)
+	dropAll(t, &b, Red, 0)
+	dropAll(t, &b, Yellow, 1)
+	dropAll(t, &b, Yellow, 1)
+	dropAll(t, &b, Red, 1)
+	dropAll(t, &b, Yellow, 2)
+	dropAll(t, &b, Red, 2)
+	row, col := dropAll(t, &b, Red, 3)
+	if !b.winner(row, col) {
+		t.Fatalf("expected diagonal win at (%d,%d), board:\n%v", row, col, b)
+	}
+}
+
+// TestWinnerFromCorner checks a diagonal win that runs through the bottom
+// row cell in column 0, the tightest corner case for the bounds check in
+// run.
+func TestWinnerFromCorner(t *testing.T) {
+	var b Board
+	row, col := dropAll(t, &b, Red, 0)
+	dropAll(t, &b, Yellow, 1)
+	dropAll(t, &b, Red, 1)
+	dropAll(t, &b, Yellow, 2)
+	dropAll(t, &b, Yellow, 2)
+	dropAll(t, &b, Red, 2)
+	dropAll(t, &b, Yellow, 3)
+	dropAll(t, &b, Yellow, 3)
+	dropAll(t, &b, Yellow, 3)
+	dropAll(t, &b, Red, 3)
+	if !b.winner(row, col) {
+		t.Fatalf("expected the corner cell (%d,%d) to be part of the winning diagonal", row, col)
+	}
+}
+
+func TestNoWinnerOnEmptyCell(t *testing.T) {
+	var b Board
+	if b.winner(rows-1, 0) {
+		t.Fatal("an empty cell can't be part of a win")
+	}
+}
+
+func TestFullBoardIsDraw(t *testing.T) {
+	var b Board
+	// Tile every cell from (row + 2*col) mod 4, which breaks up every run
+	// of 4 in all four win directions at once, then fill bottom-up per
+	// column (the order drop actually lands in) so the result is both a
+	// legal sequence of drops and a full, winner-less board.
+	for c := 0; c < cols; c++ {
+		for i := 0; i < rows; i++ {
+			row := rows - 1 - i
+			player := Red
+			if (row+2*c)%4 >= 2 {
+				player = Yellow
+			}
+			landed, ok := b.drop(c, player)
+			if !ok {
+				t.Fatalf("drop(%d, %v) rejected while filling board", c, player)
+			}
+			if landed != row {
+				t.Fatalf("drop(%d, %v) landed at row %d, expected %d", c, player, landed, row)
+			}
+			if b.winner(landed, c) {
+				t.Fatalf("unexpected win while filling column %d at row %d", c, landed)
+			}
+		}
+	}
+	if !b.full() {
+		t.Fatal("expected a full board")
+	}
+}
+
+// TestBestMoveTakesForcedWin gives Red three in a row against the left
+// wall, with column 3 the only cell that completes it, and checks the AI
+// takes the one winning move instead of playing elsewhere. depth is 1 so
+// the search can't tie the immediate win against some other move's
+// heuristic score from look-ahead.
+func TestBestMoveTakesForcedWin(t *testing.T) {
+	var b Board
+	dropAll(t, &b, Red, 0, 1, 2)
+	col, score := bestMove(&b, Red, 1)
+	if col != 3 {
+		t.Fatalf("expected the AI to complete the three at column 3, got column %d", col)
+	}
+	if score < 1_000_000 {
+		t.Fatalf("expected a winning score, got %d", score)
+	}
+}
+
+// TestBestMoveBlocksForcedLoss gives the opponent three in a row against
+// the left wall, with column 3 the only cell that would complete it, and
+// checks the AI plays there instead of losing next turn. depth is 2 so the
+// search looks one ply past its own move, far enough to see the opponent's
+// win if it doesn't block.
+func TestBestMoveBlocksForcedLoss(t *testing.T) {
+	var b Board
+	dropAll(t, &b, Yellow, 0, 1, 2)
+	col, _ := bestMove(&b, Red, 2)
+	if col != 3 {
+		t.Fatalf("expected the AI to block at column 3, got column %d", col)
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/Olisnot/Connect4-TUI/internal/theme"
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+const (
+	cellW = 9 // horizontal spacing
+	cellH = 4 // vertical spacing
+	fps   = 30
+
+	tableW = cols*cellW + 1
+	tableH = rows*cellH + 1
+
+	sidePanelW = 22
+)
+
+func tokenGlyph(c Cell, th theme.Theme) (rune, ui.Style) {
+	switch c {
+	case Red:
+		return 'O', th.PlayerRed
+	case Yellow:
+		return 'O', th.PlayerYellow
+	default:
+		return ' ', ui.DefaultStyle()
+	}
+}
+
+// boardView draws the frame, grid lines, dropped tokens and column marker
+// for a snapshot of the game. It reserves one extra row above the frame
+// for the marker, so its Context must be (tableW+1) x (tableH+2).
+type boardView struct {
+	ui.Invalidatable
+	board *Board
+	col   int
+	theme theme.Theme
+}
+
+func (v *boardView) Invalidate() { v.DoInvalidate(v) }
+
+func (v *boardView) Draw(ctx *ui.Context) {
+	const top = 1 // row 0 is reserved for the column marker
+	left, right := 0, tableW
+	bottom := top + tableH
+	frame, grid := v.theme.Frame, v.theme.Grid
+
+	for x := left + 1; x < right; x++ {
+		ctx.SetCell(x, top, '─', frame)
+		ctx.SetCell(x, bottom, '─', frame)
+	}
+	leftStyle, rightStyle := frame, frame
+	if v.col == 0 {
+		leftStyle = v.theme.Highlight
+	}
+	if v.col == cols-1 {
+		rightStyle = v.theme.Highlight
+	}
+	for y := top + 1; y < bottom; y++ {
+		ctx.SetCell(left, y, '│', leftStyle)
+		ctx.SetCell(right, y, '│', rightStyle)
+	}
+	ctx.SetCell(left, top, '┌', frame)
+	ctx.SetCell(right, top, '┐', frame)
+	ctx.SetCell(left, bottom, '└', frame)
+	ctx.SetCell(right, bottom, '┘', frame)
+
+	for r := 1; r < rows; r++ {
+		y := top + r*cellH
+		for x := left + 1; x < right; x++ {
+			ctx.SetCell(x, y, '─', grid)
+		}
+	}
+	for c := 1; c < cols; c++ {
+		x := left + c*cellW
+		style := grid
+		if c == v.col || c == v.col+1 {
+			style = v.theme.Highlight
+		}
+		for y := top + 1; y < bottom; y++ {
+			ctx.SetCell(x, y, '│', style)
+		}
+	}
+	for r := 1; r < rows; r++ {
+		for c := 1; c < cols; c++ {
+			ctx.SetCell(left+c*cellW, top+r*cellH, '┼', grid)
+		}
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if v.board[r][c] == Empty {
+				continue
+			}
+			glyph, style := tokenGlyph(v.board[r][c], v.theme)
+			x := left + c*cellW + cellW/2
+			y := top + r*cellH + cellH/2
+			ctx.SetCell(x, y, glyph, style)
+		}
+	}
+
+	markerX := left + v.col*cellW + cellW/2
+	ctx.SetCell(markerX, 0, '▼', v.theme.Highlight)
+}
+
+// statusBar centers a single line of status text in whatever width it's
+// given.
+type statusBar struct {
+	ui.Invalidatable
+	text  string
+	style ui.Style
+}
+
+func (s *statusBar) Invalidate() { s.DoInvalidate(s) }
+
+func (s *statusBar) Draw(ctx *ui.Context) {
+	runes := []rune(s.text)
+	x := (ctx.Width() - len(runes)) / 2
+	for i, r := range runes {
+		ctx.SetCell(x+i, 0, r, s.style)
+	}
+}
+
+// sidePanel renders a handful of static info lines next to the board.
+type sidePanel struct {
+	ui.Invalidatable
+	lines []string
+	style ui.Style
+}
+
+func (p *sidePanel) Invalidate() { p.DoInvalidate(p) }
+
+func (p *sidePanel) Draw(ctx *ui.Context) {
+	for y, line := range p.lines {
+		if y >= ctx.Height() {
+			return
+		}
+		for x, r := range line {
+			ctx.SetCell(x, y, r, p.style)
+		}
+	}
+}
+
+// statusText picks the status line for the current game state. A pending
+// gt.message (e.g. a save result) takes priority until the next move.
+func statusText(gt *gameTab) string {
+	switch {
+	case gt.message != "":
+		return gt.message
+	case gt.winner != Empty:
+		return gt.winner.String() + " wins! press r to play again"
+	case gt.draw:
+		return "draw! press r to play again"
+	default:
+		return gt.turn.String() + "'s turn"
+	}
+}
+
+// sidePanelLines describes the running game for the side panel.
+func sidePanelLines(gt *gameTab) []string {
+	modeLine := "Mode: two player"
+	if gt.mode == ModeSinglePlayer {
+		modeLine = "Mode: vs AI"
+	}
+	lines := []string{
+		"Connect-4 TUI",
+		"",
+		modeLine,
+	}
+	if gt.netPeer != nil {
+		lines = append(lines, fmt.Sprintf("You are %s", gt.localColor))
+	}
+	return append(lines,
+		"",
+		"Controls:",
+		"←/→ or a/d: column",
+		"space/enter: drop",
+		"r: reset",
+		"ctrl+s: save",
+		"q: quit",
+	)
+}
+
+// rootRowSpecs/rootColSpecs and contentRowSpecs/contentColSpecs describe
+// the same two nested Grids that buildGameLayout builds. boardOrigin
+// resolves them again to map a mouse event back onto the board, so the
+// two must stay in lock-step; keeping the specs in one place is what
+// makes that safe.
+func rootRowSpecs() []ui.GridSpec {
+	return []ui.GridSpec{ui.WeightedSpec(1), ui.FixedSpec(tableH + 2), ui.FixedSpec(1), ui.WeightedSpec(1)}
+}
+
+func rootColSpecs() []ui.GridSpec { return []ui.GridSpec{ui.WeightedSpec(1)} }
+
+func contentRowSpecs() []ui.GridSpec { return []ui.GridSpec{ui.FixedSpec(tableH + 2)} }
+
+func contentColSpecs() []ui.GridSpec {
+	return []ui.GridSpec{
+		ui.WeightedSpec(1),
+		ui.FixedSpec(tableW + 1),
+		ui.FixedSpec(2),
+		ui.FixedSpec(sidePanelW),
+		ui.WeightedSpec(1),
+	}
+}
+
+// buildGameLayout composes the board, status bar and side panel for gt
+// into a single root Drawable, centered as a group within whatever space
+// the Game tab is given.
+func buildGameLayout(gt *gameTab) ui.Drawable {
+	th := gt.settings.Theme
+	board := &boardView{board: &gt.board, col: gt.col, theme: th}
+	panel := ui.NewBordered(&sidePanel{lines: sidePanelLines(gt), style: th.StatusBar}, ui.AllSides, th.Frame)
+	status := &statusBar{text: statusText(gt), style: th.StatusBar}
+
+	content := ui.NewGrid(contentRowSpecs(), contentColSpecs())
+	content.AddChild(board, 0, 1)
+	content.AddChild(panel, 0, 3)
+
+	root := ui.NewGrid(rootRowSpecs(), rootColSpecs())
+	root.AddChild(content, 1, 0)
+	root.AddChild(status, 2, 0)
+	return root
+}
+
+// boardOrigin returns the coordinates of the board's local (0, 0) within
+// the space given to the Game tab, by resolving the same Grid specs
+// buildGameLayout uses. ok is false if that space is too small to have
+// laid anything out yet.
+func boardOrigin(termW, termH int) (x, y int, ok bool) {
+	if termW <= 0 || termH <= 0 {
+		return 0, 0, false
+	}
+	rowOffsets := ui.Offsets(ui.ResolveSizes(rootRowSpecs(), termH))
+	contentY := rowOffsets[1]
+	colOffsets := ui.Offsets(ui.ResolveSizes(contentColSpecs(), termW))
+	boardX := colOffsets[1]
+	return boardX, contentY, true
+}
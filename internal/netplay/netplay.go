@@ -0,0 +1,234 @@
+// Package netplay implements connect4-tui's networked two-player mode: a
+// tiny newline-delimited line protocol over TCP, independent of the game
+// package so it carries no knowledge of Board or Cell.
+package netplay
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const protocolVersion = "1"
+
+// KeepaliveInterval is how often Keepalive pings the peer.
+const KeepaliveInterval = 10 * time.Second
+
+// Color is the piece color one side of a networked game plays.
+type Color string
+
+const (
+	ColorRed    Color = "R"
+	ColorYellow Color = "Y"
+)
+
+func (c Color) opponent() Color {
+	if c == ColorRed {
+		return ColorYellow
+	}
+	return ColorRed
+}
+
+// Peer is an established connection to the other player, past the initial
+// HELLO/COLOR handshake.
+type Peer struct {
+	conn  net.Conn
+	r     *bufio.Reader
+	Color Color // the color the local side plays
+}
+
+// newPeer wraps an already-connected conn; Host and Join set Color once the
+// handshake assigns it.
+func newPeer(conn net.Conn) *Peer {
+	return &Peer{conn: conn, r: bufio.NewReader(conn)}
+}
+
+// Host listens on port, accepts a single connection, and assigns Yellow to
+// the connecting client and Red to itself, or the reverse if swap is true.
+func Host(port int, swap bool) (*Peer, error) {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+	defer ln.Close()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	clientColor := ColorYellow
+	if swap {
+		clientColor = ColorRed
+	}
+
+	p := newPeer(conn)
+	p.Color = clientColor.opponent()
+	if err := p.handshakeHost(clientColor); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+// Join dials addr and waits for the host to assign a color.
+func Join(addr string) (*Peer, error) {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := newPeer(conn)
+	color, err := p.handshakeJoin()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	p.Color = color
+	return p, nil
+}
+
+func (p *Peer) handshakeHost(clientColor Color) error {
+	if err := p.writeLine("HELLO " + protocolVersion); err != nil {
+		return err
+	}
+	line, err := p.readLine()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "HELLO ") {
+		return fmt.Errorf("unexpected handshake line %q", line)
+	}
+	return p.writeLine("COLOR " + string(clientColor))
+}
+
+func (p *Peer) handshakeJoin() (Color, error) {
+	line, err := p.readLine()
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(line, "HELLO ") {
+		return "", fmt.Errorf("unexpected handshake line %q", line)
+	}
+	if err := p.writeLine("HELLO " + protocolVersion); err != nil {
+		return "", err
+	}
+	line, err = p.readLine()
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(line)
+	if len(fields) != 2 || fields[0] != "COLOR" {
+		return "", fmt.Errorf("unexpected handshake line %q", line)
+	}
+	return Color(fields[1]), nil
+}
+
+func (p *Peer) writeLine(s string) error {
+	_, err := p.conn.Write([]byte(s + "\n"))
+	return err
+}
+
+func (p *Peer) readLine() (string, error) {
+	line, err := p.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// SendMove tells the peer a token was just dropped in col.
+func (p *Peer) SendMove(col int) error {
+	return p.writeLine(fmt.Sprintf("MOVE %d", col))
+}
+
+// SendResign tells the peer the local side is giving up.
+func (p *Peer) SendResign() error {
+	return p.writeLine("RESIGN")
+}
+
+// Close says goodbye and closes the connection.
+func (p *Peer) Close() error {
+	p.writeLine("BYE")
+	return p.conn.Close()
+}
+
+// Keepalive writes a PING to the peer every interval, so a half-open
+// connection is caught by ReadLoop's next readLine error instead of only
+// once the OS eventually times it out. It's the sender-side counterpart to
+// ReadLoop's own PING/PONG handling, and returns as soon as a write fails,
+// which happens once the connection is closed. Run it in its own
+// goroutine, alongside ReadLoop.
+func (p *Peer) Keepalive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := p.writeLine("PING"); err != nil {
+			return
+		}
+	}
+}
+
+// EventKind distinguishes the lines ReadLoop reports to its caller; PING/
+// PONG are handled internally and never reach it.
+type EventKind int
+
+const (
+	EventMove EventKind = iota
+	EventResign
+	EventBye
+)
+
+// Event is one parsed line from the peer.
+type Event struct {
+	Kind EventKind
+	Move int // set when Kind == EventMove
+}
+
+// ReadLoop blocks reading lines from the peer, calling onEvent for each
+// parsed Event, until the connection ends or a malformed line arrives, at
+// which point it calls onError once and returns. Run it in its own
+// goroutine. PING is answered with PONG and never reported; PONG is
+// swallowed as a no-op keepalive reply.
+func (p *Peer) ReadLoop(onEvent func(Event), onError func(error)) {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			onError(err)
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "MOVE":
+			if len(fields) != 2 {
+				onError(fmt.Errorf("malformed move %q", line))
+				return
+			}
+			col, err := strconv.Atoi(fields[1])
+			if err != nil {
+				onError(fmt.Errorf("malformed move %q: %w", line, err))
+				return
+			}
+			onEvent(Event{Kind: EventMove, Move: col})
+		case "RESIGN":
+			onEvent(Event{Kind: EventResign})
+		case "BYE":
+			onEvent(Event{Kind: EventBye})
+			return
+		case "PING":
+			p.writeLine("PONG")
+		case "PONG":
+			// keepalive acknowledged, nothing to do
+		default:
+			onError(fmt.Errorf("unknown protocol line %q", line))
+			return
+		}
+	}
+}
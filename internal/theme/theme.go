@@ -0,0 +1,85 @@
+// Package theme provides the named Styles the UI paints itself with, plus
+// the built-in and user-configurable palettes they come from.
+package theme
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+// Theme names the style used to paint each part of the UI.
+type Theme struct {
+	Frame        ui.Style `toml:"frame"`
+	Grid         ui.Style `toml:"grid"`
+	PlayerRed    ui.Style `toml:"player_red"`
+	PlayerYellow ui.Style `toml:"player_yellow"`
+	Highlight    ui.Style `toml:"highlight"`
+	StatusBar    ui.Style `toml:"status_bar"`
+}
+
+// Default is the theme used when no other theme is requested.
+func Default() Theme {
+	return Theme{
+		Frame:        ui.Style{FG: 8, BG: ui.NoColor},
+		Grid:         ui.Style{FG: 8, BG: ui.NoColor},
+		PlayerRed:    ui.Style{FG: 196, BG: ui.NoColor},
+		PlayerYellow: ui.Style{FG: 226, BG: ui.NoColor},
+		Highlight:    ui.Style{FG: 51, BG: ui.NoColor, Bold: true},
+		StatusBar:    ui.Style{FG: ui.NoColor, BG: ui.NoColor, Bold: true},
+	}
+}
+
+// HighContrast swaps subtlety for maximum readability: a bright frame and
+// bold, saturated tokens.
+func HighContrast() Theme {
+	return Theme{
+		Frame:        ui.Style{FG: 15, BG: ui.NoColor, Bold: true},
+		Grid:         ui.Style{FG: 15, BG: ui.NoColor},
+		PlayerRed:    ui.Style{FG: 196, BG: ui.NoColor, Bold: true},
+		PlayerYellow: ui.Style{FG: 226, BG: ui.NoColor, Bold: true},
+		Highlight:    ui.Style{FG: 201, BG: ui.NoColor, Bold: true},
+		StatusBar:    ui.Style{FG: 0, BG: 15, Reverse: false},
+	}
+}
+
+var builtins = map[string]func() Theme{
+	"default":       Default,
+	"high-contrast": HighContrast,
+}
+
+// Load resolves name to a Theme. Built-in names ("default",
+// "high-contrast") are returned directly; anything else is treated as a
+// file name (without extension) under
+// $XDG_CONFIG_HOME/connect4-tui/<name>.toml, decoded on top of Default so
+// a theme file only needs to override the fields it cares about.
+func Load(name string) (Theme, error) {
+	if builtin, ok := builtins[name]; ok {
+		return builtin(), nil
+	}
+
+	path, err := configPath(name)
+	if err != nil {
+		return Theme{}, err
+	}
+	th := Default()
+	if _, err := toml.DecodeFile(path, &th); err != nil {
+		return Theme{}, err
+	}
+	return th, nil
+}
+
+func configPath(name string) (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "connect4-tui", name+".toml"), nil
+}
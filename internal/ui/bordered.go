@@ -0,0 +1,82 @@
+package ui
+
+// Side is a bitmask of the edges Bordered draws a border on.
+type Side int
+
+const (
+	Top Side = 1 << iota
+	Bottom
+	Left
+	Right
+)
+
+const AllSides = Top | Bottom | Left | Right
+
+// Bordered draws a box-drawing border around a child Drawable on the
+// requested Sides, inset by one cell per bordered side.
+type Bordered struct {
+	Invalidatable
+	Child Drawable
+	Sides Side
+	Style Style
+}
+
+// NewBordered wraps child in a border and forwards its invalidations.
+func NewBordered(child Drawable, sides Side, style Style) *Bordered {
+	b := &Bordered{Child: child, Sides: sides, Style: style}
+	child.OnInvalidate(func(Drawable) { b.Invalidate() })
+	return b
+}
+
+func (b *Bordered) Invalidate() { b.DoInvalidate(b) }
+
+func (b *Bordered) Draw(ctx *Context) {
+	left, top := 0, 0
+	right, bottom := ctx.Width()-1, ctx.Height()-1
+
+	if b.Sides&Top != 0 {
+		for x := left; x <= right; x++ {
+			ctx.SetCell(x, top, '─', b.Style)
+		}
+	}
+	if b.Sides&Bottom != 0 {
+		for x := left; x <= right; x++ {
+			ctx.SetCell(x, bottom, '─', b.Style)
+		}
+	}
+	if b.Sides&Left != 0 {
+		for y := top; y <= bottom; y++ {
+			ctx.SetCell(left, y, '│', b.Style)
+		}
+	}
+	if b.Sides&Right != 0 {
+		for y := top; y <= bottom; y++ {
+			ctx.SetCell(right, y, '│', b.Style)
+		}
+	}
+	if b.Sides&Top != 0 && b.Sides&Left != 0 {
+		ctx.SetCell(left, top, '┌', b.Style)
+	}
+	if b.Sides&Top != 0 && b.Sides&Right != 0 {
+		ctx.SetCell(right, top, '┐', b.Style)
+	}
+	if b.Sides&Bottom != 0 && b.Sides&Left != 0 {
+		ctx.SetCell(left, bottom, '└', b.Style)
+	}
+	if b.Sides&Bottom != 0 && b.Sides&Right != 0 {
+		ctx.SetCell(right, bottom, '┘', b.Style)
+	}
+
+	inset := func(side Side) int {
+		if b.Sides&side != 0 {
+			return 1
+		}
+		return 0
+	}
+	x0, y0 := inset(Left), inset(Top)
+	w := ctx.Width() - x0 - inset(Right)
+	h := ctx.Height() - y0 - inset(Bottom)
+	if w > 0 && h > 0 {
+		b.Child.Draw(ctx.Subcontext(x0, y0, w, h))
+	}
+}
@@ -0,0 +1,93 @@
+// Package ui is a small terminal widget library modeled on aerc's UI
+// split (github.com/aerc-mail/aerc, lib/ui): widgets only know how to draw
+// themselves into the Context they're handed, and layout is somebody
+// else's job.
+package ui
+
+import "strings"
+
+// StyledCell is a single terminal cell: a glyph plus the style to render
+// it in.
+type StyledCell struct {
+	Rune  rune
+	Style Style
+}
+
+// CellBuffer is a fixed-size grid of terminal cells, addressed by (x, y)
+// with x growing right and y growing down.
+type CellBuffer struct {
+	cells  []StyledCell
+	stride int
+}
+
+func (c *CellBuffer) Init(w, h int) {
+	if w <= 0 || h <= 0 {
+		c.stride = 0
+		c.cells = nil
+		return
+	}
+	c.stride = w
+	c.cells = make([]StyledCell, w*h)
+	c.Wipe()
+}
+
+func (c *CellBuffer) Wipe() {
+	blank := StyledCell{Rune: ' ', Style: DefaultStyle()}
+	for i := range c.cells {
+		c.cells[i] = blank
+	}
+}
+
+func (c *CellBuffer) Width() int { return c.stride }
+
+func (c *CellBuffer) Height() int {
+	if c.stride == 0 {
+		return 0
+	}
+	return len(c.cells) / c.stride
+}
+
+func (c *CellBuffer) Ready() bool { return c.stride > 0 && len(c.cells) > 0 }
+
+func (c *CellBuffer) set(x, y int, cell StyledCell) {
+	if x < 0 || y < 0 || x >= c.Width() || y >= c.Height() {
+		return
+	}
+	c.cells[y*c.stride+x] = cell
+}
+
+// String renders the buffer to a plain string, emitting an ANSI escape
+// only when the style actually changes between adjacent cells (including
+// the reset back to the terminal default), rather than wrapping every
+// glyph in its own escape/reset pair.
+func (c *CellBuffer) String() string {
+	if !c.Ready() {
+		return ""
+	}
+	var out strings.Builder
+	out.Grow(len(c.cells) + c.Height())
+
+	cur := DefaultStyle()
+	for i, cell := range c.cells {
+		if i > 0 && i%c.stride == 0 && i < len(c.cells)-1 {
+			if cur != DefaultStyle() {
+				out.WriteString("\033[0m")
+				cur = DefaultStyle()
+			}
+			out.WriteByte('\n')
+		}
+		if cell.Style != cur {
+			if cell.Style == DefaultStyle() {
+				out.WriteString("\033[0m")
+			} else {
+				out.WriteString(cell.Style.ansi())
+			}
+			cur = cell.Style
+		}
+		out.WriteRune(cell.Rune)
+	}
+	if cur != DefaultStyle() {
+		out.WriteString("\033[0m")
+	}
+	return out.String()
+}
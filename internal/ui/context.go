@@ -0,0 +1,42 @@
+package ui
+
+// Context is a Drawable's view onto a sub-rectangle of a CellBuffer. All
+// coordinates passed to Context methods are local to that rectangle, so a
+// Drawable never needs to know where it ended up on screen.
+type Context struct {
+	buf  *CellBuffer
+	x, y int
+	w, h int
+}
+
+// NewContext wraps the (x, y, w, h) rectangle of buf in a Context.
+func NewContext(buf *CellBuffer, x, y, w, h int) *Context {
+	return &Context{buf: buf, x: x, y: y, w: w, h: h}
+}
+
+func (ctx *Context) Width() int  { return ctx.w }
+func (ctx *Context) Height() int { return ctx.h }
+
+// SetCell renders ch in style at (x, y), local to this context. Out-of-
+// bounds coordinates are silently dropped, same as the underlying buffer.
+func (ctx *Context) SetCell(x, y int, ch rune, style Style) {
+	if x < 0 || y < 0 || x >= ctx.w || y >= ctx.h {
+		return
+	}
+	ctx.buf.set(ctx.x+x, ctx.y+y, StyledCell{Rune: ch, Style: style})
+}
+
+// Fill paints the w x h rectangle at (x, y) with ch in style.
+func (ctx *Context) Fill(x, y, w, h int, ch rune, style Style) {
+	for yy := y; yy < y+h; yy++ {
+		for xx := x; xx < x+w; xx++ {
+			ctx.SetCell(xx, yy, ch, style)
+		}
+	}
+}
+
+// Subcontext returns a Context for the sub-rectangle (x, y, w, h) of ctx,
+// used to hand a child Drawable only the space it owns.
+func (ctx *Context) Subcontext(x, y, w, h int) *Context {
+	return NewContext(ctx.buf, ctx.x+x, ctx.y+y, w, h)
+}
@@ -0,0 +1,27 @@
+package ui
+
+// Drawable is anything that can render itself into a Context and signal
+// when it needs to be redrawn.
+type Drawable interface {
+	Draw(ctx *Context)
+	Invalidate()
+	OnInvalidate(onInvalidate func(d Drawable))
+}
+
+// Invalidatable is embedded by concrete Drawables to get a default
+// OnInvalidate implementation; the embedder still needs its own
+// Invalidate() that calls DoInvalidate(self), since Go can't recover the
+// concrete type from within the embedded struct.
+type Invalidatable struct {
+	onInvalidate func(d Drawable)
+}
+
+func (i *Invalidatable) OnInvalidate(onInvalidate func(d Drawable)) {
+	i.onInvalidate = onInvalidate
+}
+
+func (i *Invalidatable) DoInvalidate(self Drawable) {
+	if i.onInvalidate != nil {
+		i.onInvalidate(self)
+	}
+}
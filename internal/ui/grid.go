@@ -0,0 +1,117 @@
+package ui
+
+// GridSpec sizes one row or column of a Grid: either a fixed number of
+// cells, or (when Weight > 0) a share of whatever space is left over
+// after every fixed row/column has been subtracted.
+type GridSpec struct {
+	Fixed  int
+	Weight int
+}
+
+func FixedSpec(n int) GridSpec    { return GridSpec{Fixed: n} }
+func WeightedSpec(w int) GridSpec { return GridSpec{Weight: w} }
+
+// GridChild places a Drawable at a Grid's (Row, Col).
+type GridChild struct {
+	Drawable Drawable
+	Row, Col int
+}
+
+// Grid lays out children in a table of rows and columns, each sized by
+// Rows/Cols, and composes them into a single Drawable.
+type Grid struct {
+	Invalidatable
+	Rows, Cols []GridSpec
+	children   []GridChild
+}
+
+// NewGrid builds a Grid with the given row and column specs.
+func NewGrid(rowSpecs, colSpecs []GridSpec) *Grid {
+	return &Grid{Rows: rowSpecs, Cols: colSpecs}
+}
+
+// AddChild places d at (row, col) and forwards its invalidations.
+func (g *Grid) AddChild(d Drawable, row, col int) *Grid {
+	g.children = append(g.children, GridChild{Drawable: d, Row: row, Col: col})
+	d.OnInvalidate(func(Drawable) { g.Invalidate() })
+	return g
+}
+
+func (g *Grid) Invalidate() { g.DoInvalidate(g) }
+
+func (g *Grid) Draw(ctx *Context) {
+	rowSizes := layoutSpecs(g.Rows, ctx.Height())
+	colSizes := layoutSpecs(g.Cols, ctx.Width())
+	rowOffsets := offsets(rowSizes)
+	colOffsets := offsets(colSizes)
+
+	for _, child := range g.children {
+		if child.Row < 0 || child.Row >= len(rowSizes) || child.Col < 0 || child.Col >= len(colSizes) {
+			continue
+		}
+		sub := ctx.Subcontext(colOffsets[child.Col], rowOffsets[child.Row], colSizes[child.Col], rowSizes[child.Row])
+		child.Drawable.Draw(sub)
+	}
+}
+
+// layoutSpecs resolves each spec to a concrete size within total cells:
+// fixed specs get exactly what they ask for, and weighted specs split
+// whatever remains proportionally, with any rounding remainder going to
+// the last weighted spec so the sizes always sum to total.
+func layoutSpecs(specs []GridSpec, total int) []int {
+	sizes := make([]int, len(specs))
+
+	fixedSum, weightSum := 0, 0
+	for _, s := range specs {
+		if s.Weight > 0 {
+			weightSum += s.Weight
+		} else {
+			fixedSum += s.Fixed
+		}
+	}
+
+	remaining := total - fixedSum
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	used, lastWeighted := 0, -1
+	for i, s := range specs {
+		if s.Weight > 0 {
+			if weightSum > 0 {
+				sizes[i] = remaining * s.Weight / weightSum
+			}
+			used += sizes[i]
+			lastWeighted = i
+		} else {
+			sizes[i] = s.Fixed
+		}
+	}
+	if lastWeighted >= 0 {
+		sizes[lastWeighted] += remaining - used
+	}
+	return sizes
+}
+
+// ResolveSizes runs Grid's row/column sizing algorithm without a Grid,
+// for callers that need to translate raw coordinates (e.g. a mouse
+// event) into a layout Grid.Draw would have produced.
+func ResolveSizes(specs []GridSpec, total int) []int {
+	return layoutSpecs(specs, total)
+}
+
+// Offsets turns a list of sizes into cumulative start offsets, matching
+// what Grid.Draw hands each child's Subcontext.
+func Offsets(sizes []int) []int {
+	return offsets(sizes)
+}
+
+func offsets(sizes []int) []int {
+	out := make([]int, len(sizes))
+	sum := 0
+	for i, s := range sizes {
+		out[i] = sum
+		sum += s
+	}
+	return out
+}
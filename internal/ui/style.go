@@ -0,0 +1,53 @@
+package ui
+
+import "strconv"
+
+// NoColor marks a Style's FG or BG as unset, i.e. "use the terminal's
+// default color" rather than color index 0 (black).
+const NoColor = -1
+
+// Style is a terminal cell style: an optional 256-color foreground and
+// background, plus bold/reverse attributes. Themes are built entirely out
+// of Styles (see internal/theme).
+type Style struct {
+	FG      int  `toml:"fg"`
+	BG      int  `toml:"bg"`
+	Bold    bool `toml:"bold"`
+	Reverse bool `toml:"reverse"`
+}
+
+// DefaultStyle is the zero-effort style: no color, no attributes. Use this
+// instead of the bare Style{} literal, whose zero FG/BG (0) is color
+// black rather than "no color".
+func DefaultStyle() Style {
+	return Style{FG: NoColor, BG: NoColor}
+}
+
+// ansi returns the escape sequence that switches the terminal into this
+// style, or "" if the style has no visible effect.
+func (s Style) ansi() string {
+	if s == DefaultStyle() {
+		return ""
+	}
+	codes := make([]string, 0, 4)
+	if s.Bold {
+		codes = append(codes, "1")
+	}
+	if s.Reverse {
+		codes = append(codes, "7")
+	}
+	if s.FG != NoColor {
+		codes = append(codes, "38;5;"+strconv.Itoa(s.FG))
+	}
+	if s.BG != NoColor {
+		codes = append(codes, "48;5;"+strconv.Itoa(s.BG))
+	}
+	seq := "\033["
+	for i, code := range codes {
+		if i > 0 {
+			seq += ";"
+		}
+		seq += code
+	}
+	return seq + "m"
+}
@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Tab is one screen of a tabbed application: modeled on aerc's
+// lib/ui/tab.go, it names itself for the tab bar, draws its own content
+// when active, and handles its own keys.
+type Tab interface {
+	Name() string
+	Content() Drawable
+	HandleKey(msg tea.KeyMsg) tea.Cmd
+}
+
+// TabBar draws a single-line strip of "N:Name" labels, highlighting
+// whichever index is Active.
+type TabBar struct {
+	Invalidatable
+	Names                      []string
+	Active                     int
+	ActiveStyle, InactiveStyle Style
+}
+
+func (t *TabBar) Invalidate() { t.DoInvalidate(t) }
+
+func (t *TabBar) Draw(ctx *Context) {
+	x := 0
+	for i, name := range t.Names {
+		style := t.InactiveStyle
+		if i == t.Active {
+			style = t.ActiveStyle
+		}
+		for _, r := range fmt.Sprintf(" %d:%s ", i+1, name) {
+			ctx.SetCell(x, 0, r, style)
+			x++
+		}
+	}
+}
@@ -1,207 +1,164 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
-)
-
-const (
-	cols = 7
-	rows = 6
+	"github.com/mattn/go-isatty"
 
-	cellW = 9 // horizontal spacing
-	cellH = 4 // vertical spacing
-	fps   = 30
-
-	circleChar = "\033[38;5;226mO\033[0m" // yellow token
+	"github.com/Olisnot/Connect4-TUI/internal/netplay"
+	"github.com/Olisnot/Connect4-TUI/internal/theme"
 )
 
-type cellbuffer struct {
-	cells  []string
-	stride int
-}
-
-func (c *cellbuffer) init(w, h int) {
-	if w <= 0 || h <= 0 {
-		c.stride = 0
-		c.cells = nil
-		return
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
 	}
-	c.stride = w
-	c.cells = make([]string, w*h)
-	c.wipe()
-}
-
-func (c *cellbuffer) wipe() {
-	for i := range c.cells {
-		c.cells[i] = " "
+	if v > max {
+		return max
 	}
+	return v
 }
 
-func (c cellbuffer) width() int { return c.stride }
-func (c cellbuffer) height() int {
-	if c.stride == 0 {
-		return 0
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "host":
+			runHost(os.Args[2:])
+			return
+		case "join":
+			runJoin(os.Args[2:])
+			return
+		}
 	}
-	return len(c.cells) / c.stride
+	runLocal()
 }
-func (c cellbuffer) ready() bool { return c.stride > 0 && len(c.cells) > 0 }
 
-func (c cellbuffer) set(x, y int, s string) {
-	if x < 0 || y < 0 || x >= c.width() || y >= c.height() {
-		return
-	}
-	c.cells[y*c.stride+x] = s
-}
+func runLocal() {
+	modeFlag := flag.String("mode", "2p", "game mode: \"2p\" for two players, \"ai\" for single player vs the computer")
+	themeFlag := flag.String("theme", "default", "theme name: \"default\", \"high-contrast\", or a file under $XDG_CONFIG_HOME/connect4-tui/")
+	replayFlag := flag.String("replay", "", "path to a saved .c4 game to play back frame-by-frame instead of starting a new game")
+	replayIntervalFlag := flag.Duration("replay-interval", 500*time.Millisecond, "time between moves when using -replay")
+	flag.Parse()
 
-func (c cellbuffer) String() string {
-	if !c.ready() {
-		return ""
-	}
-	out := make([]byte, 0, len(c.cells)+c.height())
-	for i := 0; i < len(c.cells); i++ {
-		if i > 0 && i%c.stride == 0 && i < len(c.cells)-1 {
-			out = append(out, '\n')
-		}
-		out = append(out, c.cells[i]...)
+	mode := ModeTwoPlayer
+	if *modeFlag == "ai" {
+		mode = ModeSinglePlayer
 	}
-	return string(out)
-}
 
-// Draw a clean outer frame, then an inset grid that doesn't touch the frame.
-func drawTable(cb *cellbuffer) {
-	tableW := cols*cellW + 1
-	tableH := rows*cellH + 1
-	startX := (cb.width() - tableW) / 2
-	startY := (cb.height() - tableH) / 2
-
-	left, right := startX, startX+tableW
-	top, bottom := startY, startY+tableH
-
-	// --- outer frame (no joints from the inner grid) ---
-	// top/bottom lines
-	for x := left + 1; x < right; x++ {
-		cb.set(x, top, "─")
-		cb.set(x, bottom, "─")
-	}
-	// left/right lines
-	for y := top + 1; y < bottom; y++ {
-		cb.set(left, y, "│")
-		cb.set(right, y, "│")
-	}
-	// corners
-	cb.set(left, top, "┌")
-	cb.set(right, top, "┐")
-	cb.set(left, bottom, "└")
-	cb.set(right, bottom, "┘")
-
-	// --- inner grid (inset by 1 so it never touches the frame) ---
-	// horizontal grid lines
-	for r := 1; r < rows; r++ {
-		y := top + r*cellH
-		for x := left + 1; x < right; x++ { // stop before frame
-			cb.set(x, y, "─")
-		}
-	}
-	// vertical grid lines
-	for ccol := 1; ccol < cols; ccol++ {
-		x := left + ccol*cellW
-		for y := top + 1; y < bottom; y++ { // stop before frame
-			cb.set(x, y, "│")
-		}
-	}
-	// inner intersections only (never on the frame)
-	for r := 1; r < rows; r++ {
-		for ccol := 1; ccol < cols; ccol++ {
-			x := left + ccol*cellW
-			y := top + r*cellH
-			cb.set(x, y, "┼")
+	th := loadThemeOrDefault(themeFlag)
+
+	app := newApp(mode, *themeFlag, th)
+	if *replayFlag != "" {
+		moves, err := LoadGame(*replayFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "connect4-tui: could not load replay %q: %v\n", *replayFlag, err)
+			os.Exit(1)
 		}
+		app.tabs[tabGame].(*gameTab).startReplay(moves, *replayIntervalFlag)
+		app.active = tabGame
 	}
+
+	run(app)
 }
 
-// Token centered in the chosen cell, still inside the frame.
-func drawToken(cb *cellbuffer, col, row int) {
-	tableW := cols*cellW + 1
-	tableH := rows*cellH + 1
-	startX := (cb.width() - tableW) / 2
-	startY := (cb.height() - tableH) / 2
-
-	left, top := startX, startY
-	x := left + col*cellW + cellW/2
-	y := top + row*cellH + cellH/2
-	cb.set(x, y, circleChar)
+// runHost listens for a single opponent and plays against them once they
+// connect.
+func runHost(args []string) {
+	fs := flag.NewFlagSet("host", flag.ExitOnError)
+	port := fs.Int("port", 4077, "TCP port to listen on")
+	swap := fs.Bool("swap", false, "give the connecting client Red instead of Yellow")
+	themeFlag := fs.String("theme", "default", "theme name: \"default\", \"high-contrast\", or a file under $XDG_CONFIG_HOME/connect4-tui/")
+	fs.Parse(args)
+
+	fmt.Printf("connect4-tui: listening on :%d...\n", *port)
+	peer, err := netplay.Host(*port, *swap)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect4-tui: host failed: %v\n", err)
+		os.Exit(1)
+	}
+	runNetworked(peer, themeFlag)
 }
 
-type frameMsg struct{}
+// runJoin connects to a host started with the host subcommand.
+func runJoin(args []string) {
+	fs := flag.NewFlagSet("join", flag.ExitOnError)
+	themeFlag := fs.String("theme", "default", "theme name: \"default\", \"high-contrast\", or a file under $XDG_CONFIG_HOME/connect4-tui/")
+	fs.Parse(args)
 
-func tick() tea.Cmd {
-	return tea.Tick(time.Second/fps, func(time.Time) tea.Msg { return frameMsg{} })
-}
+	if fs.NArg() < 1 {
+		fmt.Fprintln(os.Stderr, "usage: connect4-tui join [-theme name] <host:port>")
+		os.Exit(1)
+	}
 
-type model struct {
-	buf      cellbuffer
-	col, row int
+	peer, err := netplay.Join(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect4-tui: join failed: %v\n", err)
+		os.Exit(1)
+	}
+	runNetworked(peer, themeFlag)
 }
 
-func (m model) Init() tea.Cmd { return tick() }
+// runNetworked builds an App already in a networked Game, and starts the
+// goroutine that turns peer's protocol events into tea.Msg values.
+func runNetworked(peer *netplay.Peer, themeFlag *string) {
+	th := loadThemeOrDefault(themeFlag)
+
+	localColor := Red
+	if peer.Color == netplay.ColorYellow {
+		localColor = Yellow
+	}
+
+	app := newApp(ModeTwoPlayer, *themeFlag, th)
+	app.tabs[tabGame].(*gameTab).startNetwork(peer, localColor)
+	app.active = tabGame
+
+	p := tea.NewProgram(app, programOptions()...)
+
+	go peer.ReadLoop(
+		func(ev netplay.Event) {
+			switch ev.Kind {
+			case netplay.EventMove:
+				p.Send(netMoveMsg{col: ev.Move})
+			case netplay.EventResign:
+				p.Send(netErrorMsg{message: "the other player resigned"})
+			case netplay.EventBye:
+				p.Send(netErrorMsg{message: "the other player disconnected"})
+			}
+		},
+		func(err error) { p.Send(netErrorMsg{message: fmt.Sprintf("disconnected: %v", err)}) },
+	)
+	go peer.Keepalive(netplay.KeepaliveInterval)
 
-func (m model) View() string {
-	if !m.buf.ready() {
-		return ""
+	if _, err := p.Run(); err != nil {
+		fmt.Println("terminal meltdown:", err)
+		os.Exit(1)
 	}
-	return m.buf.String()
 }
 
-func clamp(v, min, max int) int {
-	if v < min {
-		return min
-	}
-	if v > max {
-		return max
+func loadThemeOrDefault(themeFlag *string) theme.Theme {
+	th, err := theme.Load(*themeFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect4-tui: could not load theme %q (%v), using default\n", *themeFlag, err)
+		*themeFlag = "default"
+		return theme.Default()
 	}
-	return v
+	return th
 }
 
-func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		m.buf.init(msg.Width, msg.Height)
-		return m, nil
-
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "ctrl+c":
-			return m, tea.Quit
-		case "w":
-			m.row = clamp(m.row-1, 0, rows-1)
-		case "s":
-			m.row = clamp(m.row+1, 0, rows-1)
-		case "a":
-			m.col = clamp(m.col-1, 0, cols-1)
-		case "d":
-			m.col = clamp(m.col+1, 0, cols-1)
-		}
-		return m, nil
-
-	case frameMsg:
-		if !m.buf.ready() {
-			return m, tick()
-		}
-		m.buf.wipe()
-		drawTable(&m.buf)
-		drawToken(&m.buf, m.col, m.row)
-		return m, tick()
+func programOptions() []tea.ProgramOption {
+	opts := []tea.ProgramOption{tea.WithAltScreen()}
+	if isatty.IsTerminal(os.Stdout.Fd()) {
+		opts = append(opts, tea.WithMouseCellMotion())
 	}
-	return m, nil
+	return opts
 }
 
-func main() {
-	m := model{col: cols / 2, row: rows / 2}
-	p := tea.NewProgram(m, tea.WithAltScreen())
+func run(app App) {
+	p := tea.NewProgram(app, programOptions()...)
 	if _, err := p.Run(); err != nil {
 		fmt.Println("terminal meltdown:", err)
 		os.Exit(1)
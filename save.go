@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Move is one token dropped into a column, in PGN-style notation: a player
+// letter ('R' or 'Y') followed by the zero-based column, e.g. "R3".
+type Move struct {
+	Player Cell
+	Col    int
+}
+
+func (m Move) String() string {
+	return m.Player.letter() + strconv.Itoa(m.Col)
+}
+
+func (m Move) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *Move) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	mv, err := parseMove(s)
+	if err != nil {
+		return err
+	}
+	*m = mv
+	return nil
+}
+
+// letter is Cell's short form for move notation, as opposed to String's
+// full name used in the status bar.
+func (c Cell) letter() string {
+	switch c {
+	case Red:
+		return "R"
+	case Yellow:
+		return "Y"
+	default:
+		return "?"
+	}
+}
+
+func parseMove(tok string) (Move, error) {
+	if len(tok) < 2 {
+		return Move{}, fmt.Errorf("malformed move %q", tok)
+	}
+	var player Cell
+	switch tok[:1] {
+	case "R":
+		player = Red
+	case "Y":
+		player = Yellow
+	default:
+		return Move{}, fmt.Errorf("unknown player in move %q", tok)
+	}
+	col, err := strconv.Atoi(tok[1:])
+	if err != nil {
+		return Move{}, fmt.Errorf("malformed move %q: %w", tok, err)
+	}
+	if col < 0 || col >= cols {
+		return Move{}, fmt.Errorf("move %q: column out of range", tok)
+	}
+	return Move{Player: player, Col: col}, nil
+}
+
+// SaveGame writes moves to path as whitespace-separated notation, e.g.
+// "R3 Y3 R4", creating path's parent directory if needed.
+func SaveGame(path string, moves []Move) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tokens := make([]string, len(moves))
+	for i, m := range moves {
+		tokens[i] = m.String()
+	}
+	return os.WriteFile(path, []byte(strings.Join(tokens, " ")+"\n"), 0o644)
+}
+
+// LoadGame reads back a move list written by SaveGame.
+func LoadGame(path string) ([]Move, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(string(data))
+	moves := make([]Move, 0, len(fields))
+	for _, f := range fields {
+		m, err := parseMove(f)
+		if err != nil {
+			return nil, err
+		}
+		moves = append(moves, m)
+	}
+	return moves, nil
+}
+
+// dataDir is connect4-tui's data directory, following the same
+// $XDG_..._HOME convention theme.configPath uses for config.
+func dataDir() (string, error) {
+	dir := os.Getenv("XDG_DATA_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dir, "connect4-tui"), nil
+}
+
+func savesDir() (string, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "saves"), nil
+}
+
+func historyPath() (string, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "history.jsonl"), nil
+}
+
+// newSavePath names a fresh save after the current time, so repeated saves
+// never collide.
+func newSavePath() (string, error) {
+	dir, err := savesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, time.Now().Format("20060102-150405")+".c4"), nil
+}
+
+// listSaves returns the file names (not full paths) under savesDir, oldest
+// first. A missing saves directory is not an error: it just means no games
+// have been saved yet.
+func listSaves() ([]string, error) {
+	dir, err := savesDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".c4") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// historyEntry is one line of history.jsonl: a record of a completed game.
+type historyEntry struct {
+	Moves     []Move    `json:"moves"`
+	Winner    string    `json:"winner"`
+	Timestamp time.Time `json:"timestamp"`
+	Mode      string    `json:"mode"`
+	AIDepth   int       `json:"ai_depth"`
+}
+
+// recordHistory appends one historyEntry for gt's just-finished game to
+// history.jsonl.
+func recordHistory(gt *gameTab) error {
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	mode := "two player"
+	if gt.mode == ModeSinglePlayer {
+		mode = "vs AI"
+	}
+	winner := gt.winner.String()
+	if gt.draw {
+		winner = "draw"
+	}
+
+	return json.NewEncoder(f).Encode(historyEntry{
+		Moves:     gt.moves,
+		Winner:    winner,
+		Timestamp: time.Now(),
+		Mode:      mode,
+		AIDepth:   gt.settings.AIDepth,
+	})
+}
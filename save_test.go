@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseMove(t *testing.T) {
+	cases := []struct {
+		name    string
+		tok     string
+		want    Move
+		wantErr bool
+	}{
+		{name: "red", tok: "R3", want: Move{Player: Red, Col: 3}},
+		{name: "yellow", tok: "Y0", want: Move{Player: Yellow, Col: 0}},
+		{name: "missing column", tok: "R", wantErr: true},
+		{name: "unknown player", tok: "G3", wantErr: true},
+		{name: "non-numeric column", tok: "Rx", wantErr: true},
+		{name: "negative column", tok: "R-1", wantErr: true},
+		{name: "column too large", tok: "R99", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := parseMove(c.tok)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("parseMove(%q) = %v, want an error", c.tok, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMove(%q) returned unexpected error: %v", c.tok, err)
+			}
+			if got != c.want {
+				t.Fatalf("parseMove(%q) = %v, want %v", c.tok, got, c.want)
+			}
+		})
+	}
+}
+
+// TestLoadGameRejectsOutOfRangeColumn checks a hand-edited or corrupted
+// save with a column outside the board fails to load instead of silently
+// producing a truncated replay.
+func TestLoadGameRejectsOutOfRangeColumn(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corrupt.c4")
+	if err := os.WriteFile(path, []byte("R3 Y99 R4\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := LoadGame(path); err == nil {
+		t.Fatal("expected LoadGame to reject an out-of-range column")
+	}
+}
+
+func TestMoveStringRoundTrip(t *testing.T) {
+	m := Move{Player: Yellow, Col: 5}
+	got, err := parseMove(m.String())
+	if err != nil {
+		t.Fatalf("parseMove(%q) returned unexpected error: %v", m.String(), err)
+	}
+	if got != m {
+		t.Fatalf("round trip through %q = %v, want %v", m.String(), got, m)
+	}
+}
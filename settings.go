@@ -0,0 +1,25 @@
+package main
+
+import "github.com/Olisnot/Connect4-TUI/internal/theme"
+
+// themeNames lists the built-in themes the Settings tab cycles through.
+var themeNames = []string{"default", "high-contrast"}
+
+// Settings holds the knobs the Settings tab edits and the Game tab reads,
+// shared by pointer so a change takes effect immediately, without
+// restarting the program.
+type Settings struct {
+	ThemeName string
+	Theme     theme.Theme
+	AIDepth   int
+	SoundOn   bool
+}
+
+func newSettings(themeName string, th theme.Theme) *Settings {
+	return &Settings{
+		ThemeName: themeName,
+		Theme:     th,
+		AIDepth:   defaultAIDepth,
+		SoundOn:   true,
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+// helpTab is a static reference for every keybinding in the app.
+type helpTab struct {
+	ui.Invalidatable
+}
+
+func newHelpTab() *helpTab { return &helpTab{} }
+
+func (t *helpTab) Name() string         { return "Help" }
+func (t *helpTab) Content() ui.Drawable { return t }
+func (t *helpTab) Invalidate()          { t.DoInvalidate(t) }
+
+var helpLines = []string{
+	"Connect-4 TUI - Keybindings",
+	"",
+	"Global:",
+	"  tab / shift+tab    cycle tabs",
+	"  1-4                jump to a tab",
+	"  ctrl+c             quit",
+	"",
+	"Game:",
+	"  ←/→ or a/d         select column",
+	"  space / enter      drop token",
+	"  mouse              hover + click a column",
+	"  r                  reset the board",
+	"  ctrl+s             save the game",
+	"  q                  quit",
+	"",
+	"Main Menu:",
+	"  enter on \"Load\"    browse and replay a saved game",
+	"",
+	"Settings:",
+	"  ↑/↓                select a setting",
+	"  ←/→                change it",
+	"  space / enter      toggle sound",
+	"",
+	"Networked play (from the command line):",
+	"  connect4-tui host [-port N] [-swap]",
+	"  connect4-tui join <host:port>",
+	"  r (in a networked game)   resign",
+}
+
+func (t *helpTab) Draw(ctx *ui.Context) {
+	for y, line := range helpLines {
+		if y >= ctx.Height() {
+			return
+		}
+		for x, r := range line {
+			ctx.SetCell(x, y, r, ui.DefaultStyle())
+		}
+	}
+}
+
+func (t *helpTab) HandleKey(msg tea.KeyMsg) tea.Cmd { return nil }
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+// mainMenuTab is the landing screen: a short list of actions that jump
+// to other tabs. Selecting "Load" switches it into browsing mode, listing
+// saved games in place of the main items until one is chosen or esc backs
+// out.
+type mainMenuTab struct {
+	ui.Invalidatable
+	items    []string
+	selected int
+	settings *Settings
+
+	browsing bool
+	saves    []string
+	saveSel  int
+	message  string
+}
+
+func newMainMenuTab(settings *Settings) *mainMenuTab {
+	return &mainMenuTab{
+		items:    []string{"New Game", "Load", "Settings", "Quit"},
+		settings: settings,
+	}
+}
+
+func (t *mainMenuTab) Name() string         { return "Main Menu" }
+func (t *mainMenuTab) Content() ui.Drawable { return t }
+func (t *mainMenuTab) Invalidate()          { t.DoInvalidate(t) }
+
+func (t *mainMenuTab) Draw(ctx *ui.Context) {
+	title := "Connect-4 TUI"
+	for x, r := range title {
+		ctx.SetCell(x, 0, r, t.settings.Theme.Highlight)
+	}
+
+	if t.browsing {
+		header := "Saved games (enter: load, esc: back)"
+		for x, r := range header {
+			ctx.SetCell(x, 2, r, ui.DefaultStyle())
+		}
+		for i, name := range t.saves {
+			prefix := "  "
+			if i == t.saveSel {
+				prefix = "> "
+			}
+			for x, r := range prefix + name {
+				ctx.SetCell(x, i+3, r, ui.DefaultStyle())
+			}
+		}
+		return
+	}
+
+	for i, item := range t.items {
+		prefix := "  "
+		if i == t.selected {
+			prefix = "> "
+		}
+		for x, r := range prefix + item {
+			ctx.SetCell(x, i+2, r, ui.DefaultStyle())
+		}
+	}
+	if t.message != "" {
+		for x, r := range t.message {
+			ctx.SetCell(x, len(t.items)+3, r, ui.DefaultStyle())
+		}
+	}
+}
+
+func (t *mainMenuTab) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if t.browsing {
+		return t.handleBrowseKey(msg)
+	}
+	switch msg.String() {
+	case "up", "k":
+		t.selected = clamp(t.selected-1, 0, len(t.items)-1)
+	case "down", "j":
+		t.selected = clamp(t.selected+1, 0, len(t.items)-1)
+	case "enter", " ":
+		switch t.items[t.selected] {
+		case "New Game":
+			return newGameCmd()
+		case "Load":
+			t.openBrowser()
+		case "Settings":
+			return switchTabCmd(tabSettings)
+		case "Quit":
+			return tea.Quit
+		}
+	}
+	return nil
+}
+
+// openBrowser lists saved games and switches into browsing mode, or leaves
+// t.message explaining why it couldn't.
+func (t *mainMenuTab) openBrowser() {
+	saves, err := listSaves()
+	if err != nil {
+		t.message = fmt.Sprintf("could not list saves: %v", err)
+		return
+	}
+	if len(saves) == 0 {
+		t.message = "no saved games"
+		return
+	}
+	t.saves = saves
+	t.saveSel = 0
+	t.message = ""
+	t.browsing = true
+}
+
+func (t *mainMenuTab) handleBrowseKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "up", "k":
+		t.saveSel = clamp(t.saveSel-1, 0, len(t.saves)-1)
+	case "down", "j":
+		t.saveSel = clamp(t.saveSel+1, 0, len(t.saves)-1)
+	case "esc":
+		t.browsing = false
+	case "enter", " ":
+		dir, err := savesDir()
+		if err != nil {
+			t.message = err.Error()
+			t.browsing = false
+			return nil
+		}
+		moves, err := LoadGame(filepath.Join(dir, t.saves[t.saveSel]))
+		if err != nil {
+			t.message = fmt.Sprintf("load failed: %v", err)
+			t.browsing = false
+			return nil
+		}
+		t.browsing = false
+		return loadGameCmd(moves)
+	}
+	return nil
+}
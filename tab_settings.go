@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Olisnot/Connect4-TUI/internal/theme"
+	"github.com/Olisnot/Connect4-TUI/internal/ui"
+)
+
+// settingsTab lets the player change the theme, the AI's search depth,
+// and whether sound effects are on, all via a shared *Settings the Game
+// tab reads from directly.
+type settingsTab struct {
+	ui.Invalidatable
+	settings *Settings
+	row      int // which setting is selected: 0=theme, 1=AI depth, 2=sound
+}
+
+func newSettingsTab(settings *Settings) *settingsTab {
+	return &settingsTab{settings: settings}
+}
+
+func (t *settingsTab) Name() string         { return "Settings" }
+func (t *settingsTab) Content() ui.Drawable { return t }
+func (t *settingsTab) Invalidate()          { t.DoInvalidate(t) }
+
+func (t *settingsTab) Draw(ctx *ui.Context) {
+	sound := "off"
+	if t.settings.SoundOn {
+		sound = "on"
+	}
+	lines := []string{
+		fmt.Sprintf("Theme: %s", t.settings.ThemeName),
+		fmt.Sprintf("AI depth: %d", t.settings.AIDepth),
+		fmt.Sprintf("Sound: %s", sound),
+		"",
+		"↑/↓ select   ←/→ change   space toggle",
+	}
+	for i, line := range lines {
+		prefix := "  "
+		if i == t.row {
+			prefix = "> "
+		}
+		for x, r := range prefix + line {
+			ctx.SetCell(x, i, r, ui.DefaultStyle())
+		}
+	}
+}
+
+func (t *settingsTab) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	const rows = 3
+	switch msg.String() {
+	case "up", "k":
+		t.row = clamp(t.row-1, 0, rows-1)
+	case "down", "j":
+		t.row = clamp(t.row+1, 0, rows-1)
+	case "left", "a":
+		t.adjust(-1)
+	case "right", "d":
+		t.adjust(1)
+	case " ", "enter":
+		if t.row == 2 {
+			t.settings.SoundOn = !t.settings.SoundOn
+		}
+	}
+	return nil
+}
+
+// adjust nudges the currently selected setting by delta (-1 or 1).
+func (t *settingsTab) adjust(delta int) {
+	switch t.row {
+	case 0:
+		t.cycleTheme(delta)
+	case 1:
+		t.settings.AIDepth = clamp(t.settings.AIDepth+delta, minAIDepth, maxAIDepth)
+	case 2:
+		t.settings.SoundOn = !t.settings.SoundOn
+	}
+}
+
+func (t *settingsTab) cycleTheme(delta int) {
+	idx := 0
+	for i, name := range themeNames {
+		if name == t.settings.ThemeName {
+			idx = i
+			break
+		}
+	}
+	idx = (idx + delta + len(themeNames)) % len(themeNames)
+	name := themeNames[idx]
+
+	th, err := theme.Load(name)
+	if err != nil {
+		return // built-in names never fail to load; leave the theme as-is
+	}
+	t.settings.ThemeName = name
+	t.settings.Theme = th
+}